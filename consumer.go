@@ -0,0 +1,186 @@
+// Copyright (c) 2025 Joshua Skootsky
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Alternatively, you can license this code under a commercial license.
+// Contact: joshua.skootsky@gmail.com
+
+package ringbuffer
+
+import (
+	"runtime"
+	"sync/atomic"
+)
+
+// spinWait yields the processor once. It backs WriteBlocking's wait loop;
+// unlike WaitStrategy, it has no single atomic cursor to wait on since
+// backpressure depends on the minimum across potentially several gating
+// consumers, so it does not attempt to be pluggable.
+func spinWait() {
+	runtime.Gosched()
+}
+
+// Consumer is a first-class, independent read cursor into a RingBuffer.
+// Unlike the raw *uint64 cursor accepted by Read and ReadWithGap,
+// a Consumer can be gated on other consumers, letting callers wire up a
+// topology where one consumer must not race ahead of others — e.g. a
+// "journaller" and "replicator" that must both finish with an event
+// before a "business logic" consumer sees it.
+//
+// The zero value is not usable; create one with RingBuffer's NewConsumer
+// or NewGatedConsumer.
+type Consumer struct {
+	cursor atomic.Uint64
+	deps   []*Consumer
+}
+
+// Cursor returns the sequence number this consumer has read up to.
+func (c *Consumer) Cursor() uint64 {
+	return c.cursor.Load()
+}
+
+// gateLimit returns the highest sequence this consumer is allowed to read,
+// derived from the lowest cursor among its dependencies, and whether it
+// is gated at all.
+func (c *Consumer) gateLimit() (limit uint64, gated bool) {
+	if len(c.deps) == 0 {
+		return 0, false
+	}
+
+	limit = c.deps[0].cursor.Load()
+	for _, dep := range c.deps[1:] {
+		if v := dep.cursor.Load(); v < limit {
+			limit = v
+		}
+	}
+
+	return limit, true
+}
+
+// NewConsumer creates an independent Consumer with its own read cursor,
+// starting before the first sequence number.
+//
+// Example:
+//
+//	rb := ringbuffer.New[int](64)
+//	consumer := rb.NewConsumer()
+//
+//	if data, ok := rb.ReadConsumer(consumer); ok {
+//	    fmt.Println(data)
+//	}
+func (rb *RingBuffer[T]) NewConsumer() *Consumer {
+	return &Consumer{}
+}
+
+// NewGatedConsumer creates a Consumer whose Read calls (via ReadConsumer)
+// only return sequences already consumed by every consumer in deps. This
+// lets a downstream consumer wait for upstream consumers to finish with
+// an event first, forming a Disruptor-style "diamond" topology.
+//
+// Example:
+//
+//	rb := ringbuffer.New[int](64)
+//
+//	journaller := rb.NewConsumer()
+//	replicator := rb.NewConsumer()
+//	businessLogic := rb.NewGatedConsumer(journaller, replicator)
+func (rb *RingBuffer[T]) NewGatedConsumer(deps ...*Consumer) *Consumer {
+	return &Consumer{deps: deps}
+}
+
+// ReadConsumer reads the next item for c, advancing its cursor. It behaves
+// like Read, except that if c is gated (created via NewGatedConsumer) it
+// also refuses to read past the lowest cursor among c's dependencies, even
+// if the data is already published.
+func (rb *RingBuffer[T]) ReadConsumer(c *Consumer) (T, bool) {
+	var zero T
+
+	nextSeq := c.cursor.Load() + 1
+
+	if limit, gated := c.gateLimit(); gated && nextSeq > limit {
+		return zero, false
+	}
+
+	idx := nextSeq & rb.mask
+	slot := &rb.buffer[idx]
+	seq := slot.sequence.Load()
+
+	if seq != nextSeq {
+		return zero, false
+	}
+
+	data := slot.data
+	c.cursor.Store(nextSeq)
+
+	return data, true
+}
+
+// SetGatingConsumers registers the consumers that WriteBlocking must not
+// overwrite unread data for. The producer refuses to advance past
+// min(gating consumer cursor) + capacity, so data is never overwritten
+// before every gating consumer has read it.
+//
+// Example:
+//
+//	rb := ringbuffer.New[int](64)
+//	c := rb.NewConsumer()
+//	rb.SetGatingConsumers(c)
+func (rb *RingBuffer[T]) SetGatingConsumers(cs ...*Consumer) {
+	rb.gatingConsumers = cs
+}
+
+// minGatingCursor returns the lowest cursor among the registered gating
+// consumers, or the writer cursor itself (placing no constraint on
+// WriteBlocking) if none are registered.
+func (rb *RingBuffer[T]) minGatingCursor() uint64 {
+	if len(rb.gatingConsumers) == 0 {
+		return rb.writerCursor.Load()
+	}
+
+	min := rb.gatingConsumers[0].cursor.Load()
+	for _, c := range rb.gatingConsumers[1:] {
+		if v := c.cursor.Load(); v < min {
+			min = v
+		}
+	}
+
+	return min
+}
+
+// WriteBlocking appends data like Write, but gives lossless backpressure
+// instead of silently overwriting unread data: it waits until the slot it
+// would claim has already been read by every consumer registered via
+// SetGatingConsumers, i.e. until writerCursor+1 <= min(gating cursor) +
+// capacity.
+//
+// The wait-then-claim is a single CAS loop on writerCursor, so that under
+// WithMultipleProducers a producer can't pass the backpressure check
+// against one sequence and then claim a different one out from under it.
+//
+// Example:
+//
+//	rb := ringbuffer.New[int](64)
+//	c := rb.NewConsumer()
+//	rb.SetGatingConsumers(c)
+//
+//	rb.WriteBlocking(42) // waits if the buffer is full of unread data
+func (rb *RingBuffer[T]) WriteBlocking(data T) {
+	capacity := uint64(len(rb.buffer))
+
+	for {
+		cur := rb.writerCursor.Load()
+		seq := cur + 1
+
+		if seq > rb.minGatingCursor()+capacity {
+			spinWait()
+			continue
+		}
+
+		if rb.writerCursor.CompareAndSwap(cur, seq) {
+			rb.publishClaimed(seq, data)
+			return
+		}
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright (c) 2025 Joshua Skootsky
+//
+// Licensed under the Business Source License 1.1
+// You may use this file only in compliance with one of:
+// 1. BSL-1.1 (non-production use is free)
+// 2. Commercial License (contact for pricing)
+//
+// After 4 years (2029-01-01), this becomes Apache-2.0
+
+package ringbuffer
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRingBuffer_NewConsumer(t *testing.T) {
+	rb := New[int](64)
+	rb.Write(1)
+	rb.Write(2)
+
+	c := rb.NewConsumer()
+
+	data, ok := rb.ReadConsumer(c)
+	if !ok || data != 1 {
+		t.Fatalf("expected (1, true), got (%d, %v)", data, ok)
+	}
+	if c.Cursor() != 1 {
+		t.Fatalf("expected cursor 1, got %d", c.Cursor())
+	}
+}
+
+func TestRingBuffer_GatedConsumer_Diamond(t *testing.T) {
+	rb := New[int](64)
+	rb.Write(10)
+	rb.Write(20)
+
+	journaller := rb.NewConsumer()
+	replicator := rb.NewConsumer()
+	businessLogic := rb.NewGatedConsumer(journaller, replicator)
+
+	if _, ok := rb.ReadConsumer(businessLogic); ok {
+		t.Fatal("gated consumer should not read ahead of its dependencies")
+	}
+
+	if data, ok := rb.ReadConsumer(journaller); !ok || data != 10 {
+		t.Fatalf("expected (10, true), got (%d, %v)", data, ok)
+	}
+
+	if _, ok := rb.ReadConsumer(businessLogic); ok {
+		t.Fatal("gated consumer should still wait on replicator")
+	}
+
+	if data, ok := rb.ReadConsumer(replicator); !ok || data != 10 {
+		t.Fatalf("expected (10, true), got (%d, %v)", data, ok)
+	}
+
+	if data, ok := rb.ReadConsumer(businessLogic); !ok || data != 10 {
+		t.Fatalf("expected gated consumer to read 10 once both deps advanced, got (%d, %v)", data, ok)
+	}
+}
+
+func TestRingBuffer_WriteBlocking_Backpressure(t *testing.T) {
+	const size = 4
+	rb := New[int](size)
+
+	c := rb.NewConsumer()
+	rb.SetGatingConsumers(c)
+
+	for i := 0; i < size; i++ {
+		rb.WriteBlocking(i)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		rb.WriteBlocking(size) // would overwrite seq 1 before c has read it
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("WriteBlocking should not advance past an unread slot")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	if _, ok := rb.ReadConsumer(c); !ok {
+		t.Fatal("expected a readable item")
+	}
+
+	<-done
+}
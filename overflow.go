@@ -0,0 +1,179 @@
+// Copyright (c) 2025 Joshua Skootsky
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Alternatively, you can license this code under a commercial license.
+// Contact: joshua.skootsky@gmail.com
+
+package ringbuffer
+
+// OverflowPolicy selects how Write behaves when the buffer is full,
+// i.e. when the slot it would claim still holds a sequence from one lap
+// earlier. Set it with WithOverflowPolicy.
+type OverflowPolicy int
+
+const (
+	// PolicyOverwrite always claims and publishes the next sequence,
+	// silently overwriting unread data. This is the default and
+	// preserves the original wait-free, always-succeeds behavior of
+	// Write.
+	PolicyOverwrite OverflowPolicy = iota
+
+	// PolicyDrop refuses to overwrite unread data: Write returns false
+	// and leaves the buffer untouched instead of claiming a slot that a
+	// gating consumer (see SetGatingConsumers) hasn't read yet. Without
+	// any registered gating consumers there is no reader position to
+	// check unread-ness against, so PolicyDrop behaves like
+	// PolicyOverwrite (it always writes) until at least one consumer is
+	// registered.
+	PolicyDrop
+
+	// PolicyBlock waits (see WriteBlocking) until the slowest gating
+	// consumer has advanced far enough that the claim would not
+	// overwrite unread data, giving lossless backpressure at the cost
+	// of Write no longer being wait-free.
+	PolicyBlock
+)
+
+// Stats reports cumulative counters maintained by the ring buffer. All
+// fields are updated with lightweight atomics on the Write and
+// Read/ReadWithGap/ReadBatch paths, so calling Stats never blocks a
+// producer or consumer.
+type Stats struct {
+	// Written is the number of items successfully written (via Write or
+	// WriteBatch).
+	Written uint64
+	// Overwritten is the number of items lost to overflow: overwritten
+	// in place under PolicyOverwrite, or refused under PolicyDrop. It is
+	// only tracked when at least one gating consumer is registered via
+	// SetGatingConsumers, since without one there is no reader position
+	// to confirm data was actually unread rather than merely one lap
+	// old.
+	Overwritten uint64
+	// GapsDetected is the number of times ReadWithGap or ReadBatch
+	// reported a gap.
+	GapsDetected uint64
+	// MaxLagObserved is the largest observed distance, in sequence
+	// numbers, between the writer and the slowest gating consumer. It is
+	// only tracked when at least one gating consumer is registered via
+	// SetGatingConsumers.
+	MaxLagObserved uint64
+}
+
+// Stats returns a snapshot of the ring buffer's cumulative counters.
+//
+// Example:
+//
+//	rb := ringbuffer.New[int](64)
+//	rb.Write(1)
+//	fmt.Println(rb.Stats().Written) // 1
+func (rb *RingBuffer[T]) Stats() Stats {
+	return Stats{
+		Written:        rb.statsWritten.Load(),
+		Overwritten:    rb.statsOverwritten.Load(),
+		GapsDetected:   rb.statsGapsDetected.Load(),
+		MaxLagObserved: rb.statsMaxLag.Load(),
+	}
+}
+
+// OnOverrun registers a callback invoked synchronously from Write whenever
+// data is lost to overflow (overwritten under PolicyOverwrite, or
+// refused under PolicyDrop), so telemetry can raise an alert without
+// polling Stats. fn receives the number of records lost in that call.
+//
+// Like Stats.Overwritten, this only fires when at least one gating
+// consumer is registered via SetGatingConsumers; without one, the buffer
+// has no reader position to confirm data was actually unread.
+//
+// Example:
+//
+//	rb := ringbuffer.New[int](64, ringbuffer.WithOverflowPolicy(ringbuffer.PolicyDrop))
+//	rb.SetGatingConsumers(rb.NewConsumer())
+//	rb.OnOverrun(func(dropped uint64) {
+//	    log.Printf("ring buffer dropped %d records", dropped)
+//	})
+func (rb *RingBuffer[T]) OnOverrun(fn func(dropped uint64)) {
+	rb.onOverrun = fn
+}
+
+// writeDrop is the PolicyDrop implementation of Write: it refuses to
+// claim a slot that would overwrite data a gating consumer hasn't read
+// yet, returning false instead.
+//
+// The check-then-claim is done as a single CAS loop on writerCursor, so
+// that under WithMultipleProducers a producer can't pass the drop check
+// against one sequence and then claim a different one out from under it.
+func (rb *RingBuffer[T]) writeDrop(data T) bool {
+	capacity := uint64(len(rb.buffer))
+
+	for {
+		cur := rb.writerCursor.Load()
+		seq := cur + 1
+
+		if seq > capacity {
+			if unread, known := rb.overwroteUnread(seq, capacity); known && unread {
+				rb.statsOverwritten.Add(1)
+				if rb.onOverrun != nil {
+					rb.onOverrun(1)
+				}
+				return false
+			}
+		}
+
+		if rb.writerCursor.CompareAndSwap(cur, seq) {
+			rb.publishClaimed(seq, data)
+			return true
+		}
+	}
+}
+
+// overwroteUnread reports whether claiming seq (which wraps around to
+// reuse the slot last written at seq-capacity) would overwrite data that
+// the slowest gating consumer hasn't read yet, and whether that could be
+// determined at all. Without any registered gating consumers there is no
+// reader position to check against, so known is false — mirroring
+// trackLag's no-op in that case — rather than conservatively assuming
+// loss and reporting an overwrite that may not have happened.
+func (rb *RingBuffer[T]) overwroteUnread(seq, capacity uint64) (unread, known bool) {
+	if len(rb.gatingConsumers) == 0 {
+		return false, false
+	}
+	return seq-capacity > rb.minGatingCursor(), true
+}
+
+// publishClaimed writes data into the slot for an already-claimed
+// sequence, publishes it, and updates the Written/MaxLagObserved stats.
+// Callers are responsible for any PolicyOverwrite/PolicyDrop overflow
+// accounting before claiming, since by the time a sequence is claimed
+// it's too late to refuse it.
+func (rb *RingBuffer[T]) publishClaimed(seq uint64, data T) {
+	rb.Slot(seq).data = data
+	rb.Publish(seq)
+
+	rb.statsWritten.Add(1)
+	rb.trackLag(seq)
+}
+
+// trackLag updates MaxLagObserved from the distance between seq and the
+// slowest gating consumer. It is a no-op without any registered gating
+// consumers, since there is otherwise no reader position to measure lag
+// against.
+func (rb *RingBuffer[T]) trackLag(seq uint64) {
+	if len(rb.gatingConsumers) == 0 {
+		return
+	}
+
+	lag := seq - rb.minGatingCursor()
+
+	for {
+		cur := rb.statsMaxLag.Load()
+		if lag <= cur {
+			return
+		}
+		if rb.statsMaxLag.CompareAndSwap(cur, lag) {
+			return
+		}
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright (c) 2025 Joshua Skootsky
+//
+// Licensed under the Business Source License 1.1
+// You may use this file only in compliance with one of:
+// 1. BSL-1.1 (non-production use is free)
+// 2. Commercial License (contact for pricing)
+//
+// After 4 years (2029-01-01), this becomes Apache-2.0
+
+package ringbuffer
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestRingBuffer_Stats_Written(t *testing.T) {
+	rb := New[int](64)
+
+	rb.Write(1)
+	rb.Write(2)
+	rb.WriteBatch([]int{3, 4, 5})
+
+	if got := rb.Stats().Written; got != 5 {
+		t.Fatalf("expected 5 written, got %d", got)
+	}
+}
+
+func TestRingBuffer_Stats_NoSpuriousOverwrite(t *testing.T) {
+	const size = 4
+	rb := New[int](size)
+
+	var overruns uint64
+	rb.OnOverrun(func(n uint64) {
+		atomic.AddUint64(&overruns, n)
+	})
+
+	var cursor uint64
+	for i := 0; i < 100; i++ {
+		rb.Write(i)
+		if _, ok := rb.Read(&cursor); !ok {
+			t.Fatalf("expected a readable item at i=%d", i)
+		}
+	}
+
+	if got := rb.Stats().Overwritten; got != 0 {
+		t.Fatalf("expected 0 overwritten with no gating consumers, got %d", got)
+	}
+	if got := atomic.LoadUint64(&overruns); got != 0 {
+		t.Fatalf("expected 0 OnOverrun calls with no gating consumers, got %d", got)
+	}
+}
+
+func TestRingBuffer_PolicyDrop(t *testing.T) {
+	const size = 4
+	rb := New[int](size, WithOverflowPolicy(PolicyDrop))
+
+	c := rb.NewConsumer()
+	rb.SetGatingConsumers(c)
+
+	var dropped uint64
+	rb.OnOverrun(func(n uint64) {
+		atomic.AddUint64(&dropped, n)
+	})
+
+	for i := 0; i < size; i++ {
+		if ok := rb.Write(i); !ok {
+			t.Fatalf("expected write %d to succeed", i)
+		}
+	}
+
+	if ok := rb.Write(size); ok {
+		t.Fatal("expected write to be dropped when buffer is full of unread data")
+	}
+
+	if got := rb.Stats().Overwritten; got != 1 {
+		t.Fatalf("expected 1 overwritten/dropped, got %d", got)
+	}
+	if atomic.LoadUint64(&dropped) != 1 {
+		t.Fatalf("expected OnOverrun to report 1 dropped, got %d", dropped)
+	}
+
+	if _, ok := rb.ReadConsumer(c); !ok {
+		t.Fatal("expected a readable item")
+	}
+
+	if ok := rb.Write(size); !ok {
+		t.Fatal("expected write to succeed once consumer has caught up")
+	}
+}
+
+func TestRingBuffer_WriteBatch_OverwriteAccounting(t *testing.T) {
+	const size = 4
+	rb := New[int](size)
+
+	c := rb.NewConsumer()
+	rb.SetGatingConsumers(c)
+
+	var dropped uint64
+	rb.OnOverrun(func(n uint64) {
+		atomic.AddUint64(&dropped, n)
+	})
+
+	rb.WriteBatch([]int{0, 1, 2, 3})
+	rb.WriteBatch([]int{4, 5})
+
+	if got := rb.Stats().Written; got != 6 {
+		t.Fatalf("expected 6 written, got %d", got)
+	}
+	if got := rb.Stats().Overwritten; got != 2 {
+		t.Fatalf("expected 2 overwritten, got %d", got)
+	}
+	if got := atomic.LoadUint64(&dropped); got != 2 {
+		t.Fatalf("expected OnOverrun to report 2 overwritten, got %d", got)
+	}
+}
+
+func TestRingBuffer_PolicyDrop_MultipleProducers(t *testing.T) {
+	const size = 64
+	const producers = 8
+	const perProducer = 100
+
+	rb := New[int](size, WithOverflowPolicy(PolicyDrop), WithMultipleProducers())
+
+	c := rb.NewConsumer()
+	rb.SetGatingConsumers(c) // never read from c, so the buffer fills and stays full
+
+	var succeeded uint64
+	var wg sync.WaitGroup
+	wg.Add(producers)
+	for p := 0; p < producers; p++ {
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perProducer; i++ {
+				if rb.Write(i) {
+					atomic.AddUint64(&succeeded, 1)
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	// The check-then-claim race would let producers pass the drop check
+	// against a stale cursor and all claim distinct sequences anyway,
+	// admitting more than size successful writes and overwriting data no
+	// consumer had read yet.
+	if got := atomic.LoadUint64(&succeeded); got != size {
+		t.Fatalf("expected exactly %d successful writes before the buffer fills, got %d", size, got)
+	}
+	if got := rb.Stats().Written; got != size {
+		t.Fatalf("expected %d written, got %d", size, got)
+	}
+	if want := uint64(producers*perProducer) - size; rb.Stats().Overwritten != want {
+		t.Fatalf("expected %d dropped, got %d", want, rb.Stats().Overwritten)
+	}
+}
+
+func TestRingBuffer_PolicyBlock(t *testing.T) {
+	const size = 4
+	rb := New[int](size, WithOverflowPolicy(PolicyBlock))
+
+	c := rb.NewConsumer()
+	rb.SetGatingConsumers(c)
+
+	for i := 0; i < size; i++ {
+		rb.Write(i)
+	}
+
+	done := make(chan struct{})
+	go func() {
+		rb.Write(size)
+		close(done)
+	}()
+
+	if _, ok := rb.ReadConsumer(c); !ok {
+		t.Fatal("expected a readable item")
+	}
+
+	<-done
+}
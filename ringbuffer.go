@@ -12,13 +12,16 @@
 //
 // # Thread-Safety Guarantees
 //
-// This ring buffer is lock-free and wait-free for its documented use case:
+// By default this ring buffer is lock-free and wait-free for its documented
+// use case:
 //   - Single goroutine may call Write (the producer)
 //   - Single goroutine may call Read/ReadWithGap (the consumer)
 //   - All other goroutines must not access the buffer
 //
 // Violating these constraints (multiple producers or consumers) will cause
-// data races and undefined behavior.
+// data races and undefined behavior, unless the buffer was created with
+// WithMultipleProducers for many concurrent producers. See WithMultipleProducers
+// for details.
 //
 // # Performance Characteristics
 //
@@ -101,6 +104,97 @@ type RingBuffer[T any] struct {
 
 	writerCursor atomic.Uint64
 	_            [cacheLinePad - 8]byte
+
+	// publishedCursor tracks the highest contiguously published sequence.
+	// It is always maintained, single- or multi-producer: bulk readers
+	// like ReadBatch need it to know how many slots past *cursor are
+	// actually published (not merely claimed) without re-checking every
+	// slot's own sequence number individually.
+	publishedCursor atomic.Uint64
+	_               [cacheLinePad - 8]byte
+
+	multiProducer bool
+	waitStrategy  WaitStrategy
+
+	gatingConsumers []*Consumer
+
+	overflowPolicy OverflowPolicy
+	onOverrun      func(dropped uint64)
+
+	statsWritten      atomic.Uint64
+	statsOverwritten  atomic.Uint64
+	statsGapsDetected atomic.Uint64
+	statsMaxLag       atomic.Uint64
+}
+
+// Option configures optional RingBuffer behavior at construction time.
+// Options are applied in order by New.
+type Option func(*options)
+
+type options struct {
+	multiProducer  bool
+	waitStrategy   WaitStrategy
+	overflowPolicy OverflowPolicy
+}
+
+// WithMultipleProducers enables many concurrent goroutines to call Write
+// (or Claim/Publish) safely. The publish path is the same with or without
+// this option: Publish always runs the CAS loop that tracks the highest
+// contiguously published sequence.
+//
+// Without this option, Write is single-producer only.
+//
+// With this option, claiming a sequence (via Write or Claim) remains a
+// simple atomic increment, so producers never block each other. Publish
+// order can differ from claim order, though: a slow producer may still be
+// writing its slot while a faster producer has already published a later
+// sequence. Read and ReadWithGap account for this by treating a
+// claimed-but-not-yet-published slot as "not ready" rather than a gap.
+//
+// Example:
+//
+//	rb := ringbuffer.New[int](64, ringbuffer.WithMultipleProducers())
+//
+//	for p := 0; p < 4; p++ {
+//	    go func() {
+//	        seq := rb.Claim()
+//	        slot := rb.Slot(seq)
+//	        slot.data = 42
+//	        rb.Publish(seq)
+//	    }()
+//	}
+func WithMultipleProducers() Option {
+	return func(o *options) {
+		o.multiProducer = true
+	}
+}
+
+// WithWaitStrategy wires strategy into the publish path so that, if it is
+// a *BlockingWait, Write and Publish signal it after a sequence becomes
+// ready and blocked consumers wake promptly instead of waiting out their
+// next poll interval. Buffers that never use BlockingWait pay nothing
+// extra: the check is a single type assertion on the publish path.
+//
+// This option does not select a default strategy for ReadBlocking or
+// ReadBatchWait: both always use the strategy passed explicitly to that
+// call, never anything stored on the buffer. If that strategy is a
+// *BlockingWait, you must also pass the same one here — BlockingWait has
+// no timeout or poll fallback, so without this option nothing ever calls
+// its notify and a consumer blocked in ReadBlocking/ReadBatchWait would
+// wait forever.
+func WithWaitStrategy(strategy WaitStrategy) Option {
+	return func(o *options) {
+		o.waitStrategy = strategy
+	}
+}
+
+// WithOverflowPolicy selects how Write behaves when the buffer is full.
+// See OverflowPolicy for the available policies. If this option is not
+// supplied, PolicyOverwrite (today's default behavior) is used.
+func WithOverflowPolicy(policy OverflowPolicy) Option {
+	return func(o *options) {
+		o.overflowPolicy = policy
+	}
 }
 
 // New creates a new RingBuffer with the given capacity.
@@ -111,21 +205,35 @@ type RingBuffer[T any] struct {
 //
 // Panics if size is not a power of two.
 //
+// By default the returned buffer is single-producer single-consumer. Pass
+// WithMultipleProducers to allow many concurrent producers.
+//
 // Example:
 //
 //	// Create a buffer for 64 items
-//	rb := ringbuffer.New(64)
+//	rb := ringbuffer.New[int](64)
 //
 //	// Create a buffer for 1024 items
-//	rb = ringbuffer.New(1024)
-func New[T any](size uint64) *RingBuffer[T] {
+//	rb = ringbuffer.New[int](1024)
+//
+//	// Create a buffer that accepts concurrent producers
+//	mp := ringbuffer.New[int](64, ringbuffer.WithMultipleProducers())
+func New[T any](size uint64, opts ...Option) *RingBuffer[T] {
 	if size&(size-1) != 0 {
 		panic("size must be power of 2")
 	}
 
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	rb := &RingBuffer[T]{
-		buffer: make([]Slot[T], size),
-		mask:   size - 1,
+		buffer:         make([]Slot[T], size),
+		mask:           size - 1,
+		multiProducer:  o.multiProducer,
+		waitStrategy:   o.waitStrategy,
+		overflowPolicy: o.overflowPolicy,
 	}
 
 	for i := uint64(0); i < size; i++ {
@@ -138,28 +246,143 @@ func New[T any](size uint64) *RingBuffer[T] {
 // Write appends data to the ring buffer. This method is wait-free and
 // always succeeds: it atomically claims a slot and writes the data.
 //
-// The write operation is single-producer safe: only one goroutine should
-// call Write. Multiple producers will cause data races.
+// By default, only one goroutine should call Write: multiple producers
+// will cause data races. Pass WithMultipleProducers to New to allow many
+// concurrent producers instead.
 //
-// When the buffer is full, Write overwrites the oldest slot. This behavior
-// is intentional for use cases like monitoring and telemetry where:
+// By default (PolicyOverwrite), when the buffer is full Write overwrites
+// the oldest slot. This behavior is intentional for use cases like
+// monitoring and telemetry where:
 //   - Blocking is unacceptable (wait-free requirement)
 //   - Losing old data is preferable to blocking
 //   - Recent data is more valuable than complete history
 //
+// WithOverflowPolicy selects a different tradeoff: PolicyDrop makes Write
+// return false instead of overwriting unread data, and PolicyBlock makes
+// Write wait (see WriteBlocking) until a gating consumer has caught up.
+// The return value is only meaningful under PolicyDrop; the other
+// policies always return true.
+//
 // Example:
 //
 //	rb := ringbuffer.New(64)
 //	rb.Write("hello")
 //	rb.Write(42)
 //	rb.Write(someStruct{Value: "data"})
-func (rb *RingBuffer[T]) Write(data T) {
-	seq := rb.writerCursor.Add(1)
-	idx := seq & rb.mask
+func (rb *RingBuffer[T]) Write(data T) bool {
+	switch rb.overflowPolicy {
+	case PolicyBlock:
+		rb.WriteBlocking(data)
+		return true
+	case PolicyDrop:
+		return rb.writeDrop(data)
+	default:
+		return rb.writeOverwrite(data)
+	}
+}
 
-	slot := &rb.buffer[idx]
-	slot.data = data
-	slot.sequence.Store(seq)
+// writeOverwrite is the PolicyOverwrite (default) implementation of
+// Write: it always claims and publishes the next sequence, overwriting
+// whatever was in that slot, and updates Stats accordingly.
+func (rb *RingBuffer[T]) writeOverwrite(data T) bool {
+	seq := rb.Claim()
+	capacity := uint64(len(rb.buffer))
+
+	if seq > capacity {
+		if unread, known := rb.overwroteUnread(seq, capacity); known && unread {
+			rb.statsOverwritten.Add(1)
+			if rb.onOverrun != nil {
+				rb.onOverrun(1)
+			}
+		}
+	}
+
+	rb.publishClaimed(seq, data)
+
+	return true
+}
+
+// Claim atomically reserves the next sequence number for a producer to
+// write into, without touching the slot's data or sequence. It is the
+// first half of the two-phase Claim/Publish API, which lets a producer
+// populate a large T in place (via Slot) instead of copying it through
+// Write.
+//
+// Claim is safe to call from multiple goroutines only when the buffer was
+// created with WithMultipleProducers; otherwise only a single producer
+// goroutine may call it.
+//
+// A claimed sequence must eventually be published with Publish, or
+// consumers downstream of it will stall waiting for it to become ready.
+//
+// Example:
+//
+//	seq := rb.Claim()
+//	slot := rb.Slot(seq)
+//	slot.data = largeStruct{...}
+//	rb.Publish(seq)
+func (rb *RingBuffer[T]) Claim() uint64 {
+	return rb.writerCursor.Add(1)
+}
+
+// Slot returns the buffer slot for a previously claimed sequence number,
+// so a producer can populate its data field directly. Callers must not
+// read the slot's data until after they observe it published via Read or
+// ReadWithGap.
+func (rb *RingBuffer[T]) Slot(seq uint64) *Slot[T] {
+	return &rb.buffer[seq&rb.mask]
+}
+
+// Publish makes a previously claimed sequence visible to consumers by
+// storing its sequence number into the slot. It is the second half of the
+// two-phase Claim/Publish API.
+//
+// Publish also advances publishedCursor, the highest contiguously
+// published sequence, so that Read and ReadWithGap can distinguish a slot
+// that is merely claimed-but-not-yet-published from a genuine gap, and so
+// that bulk readers like ReadBatch know how much of the buffer is safe to
+// copy without re-checking every slot's sequence number.
+func (rb *RingBuffer[T]) Publish(seq uint64) {
+	rb.buffer[seq&rb.mask].sequence.Store(seq)
+
+	rb.advancePublishedCursor(seq)
+
+	if bw, ok := rb.waitStrategy.(*BlockingWait); ok {
+		bw.notify()
+	}
+}
+
+// advancePublishedCursor extends publishedCursor as far as the currently
+// published slots allow. If seq is not the immediate successor of the
+// current published cursor, some earlier sequence is still in flight and
+// the cursor cannot advance yet; the producer that eventually publishes
+// that earlier sequence will carry the cursor forward instead.
+func (rb *RingBuffer[T]) advancePublishedCursor(seq uint64) {
+	for {
+		cur := rb.publishedCursor.Load()
+		if seq <= cur {
+			return
+		}
+		if cur+1 != seq {
+			return
+		}
+
+		next := seq
+		for rb.buffer[(next+1)&rb.mask].sequence.Load() == next+1 {
+			next++
+		}
+
+		if rb.publishedCursor.CompareAndSwap(cur, next) {
+			// The producer of next+1 may have stored its sequence after
+			// the scan above but loaded publishedCursor before this CAS,
+			// and so returned without advancing it. Re-check so that
+			// sequence is not stranded behind the cursor.
+			if rb.buffer[(next+1)&rb.mask].sequence.Load() != next+1 {
+				return
+			}
+			seq = next + 1
+		}
+	}
 }
 
 // Read reads the next item from the ring buffer, advancing the cursor.
@@ -278,8 +501,16 @@ func (rb *RingBuffer[T]) ReadWithGap(cursor *uint64, gapStart, gapEnd *uint64) (
 	}
 
 	if seq > nextSeq {
+		if rb.publishedCursor.Load() < nextSeq {
+			// Some producer has already published a later lap into this
+			// slot, but nextSeq itself is still only claimed, not
+			// published. That is an in-flight write, not a gap.
+			return zero, false
+		}
+
 		*gapStart = nextSeq
 		*gapEnd = seq - 1
+		rb.statsGapsDetected.Add(1)
 		return zero, false
 	}
 
@@ -289,6 +520,177 @@ func (rb *RingBuffer[T]) ReadWithGap(cursor *uint64, gapStart, gapEnd *uint64) (
 	return data, true
 }
 
+// WriteBatch appends items to the ring buffer as a single batch, claiming
+// all of their sequence numbers with one atomic writerCursor.Add instead
+// of one per item. This amortizes the atomic and index-mask cost of
+// Write across the whole batch, which matters under burst traffic.
+//
+// Like Write, WriteBatch is single-producer safe unless the buffer was
+// created with WithMultipleProducers.
+//
+// WriteBatch always claims and publishes the whole batch, regardless of
+// the buffer's OverflowPolicy: PolicyDrop and PolicyBlock apply per item
+// and don't have a sensible meaning for a single atomic multi-sequence
+// claim (drop or block on item 3 of 5 after 1 and 2 already claimed
+// their slots?). Any item that overwrites unread data is still counted
+// in Stats.Overwritten and reported via OnOverrun, exactly as Write does
+// under PolicyOverwrite. Callers that need per-item Drop/Block semantics
+// for bursts should call Write in a loop instead.
+//
+// Example:
+//
+//	rb := ringbuffer.New[int](64)
+//	rb.WriteBatch([]int{1, 2, 3})
+func (rb *RingBuffer[T]) WriteBatch(items []T) {
+	n := uint64(len(items))
+	if n == 0 {
+		return
+	}
+
+	capacity := uint64(len(rb.buffer))
+
+	end := rb.writerCursor.Add(n)
+	start := end - n + 1
+
+	for i, item := range items {
+		seq := start + uint64(i)
+
+		if seq > capacity {
+			if unread, known := rb.overwroteUnread(seq, capacity); known && unread {
+				rb.statsOverwritten.Add(1)
+				if rb.onOverrun != nil {
+					rb.onOverrun(1)
+				}
+			}
+		}
+
+		rb.Slot(seq).data = item
+	}
+	for i := range items {
+		rb.Publish(start + uint64(i))
+	}
+
+	rb.statsWritten.Add(n)
+	rb.trackLag(end)
+}
+
+// readyCursor returns the cursor a WaitStrategy (or a bulk reader like
+// ReadBatch) should read to learn how much of the buffer is actually
+// published, as opposed to merely claimed.
+func (rb *RingBuffer[T]) readyCursor() *atomic.Uint64 {
+	return &rb.publishedCursor
+}
+
+// ReadBatch drains as many contiguous ready items as fit into out in a
+// single call, returning the number of items read. Like ReadWithGap, it
+// reports a gap via gapStart/gapEnd (with n == 0) if the producer has
+// overwritten data the consumer hasn't read yet.
+//
+// Only the first slot is bounds-checked against its sequence number;
+// subsequent items are copied in one loop up to the number already known
+// to be published (min(len(out), ready-cursor - *cursor)), so ReadBatch
+// does not re-load a sequence atomic per element the way Read would if
+// called len(out) times.
+//
+// This is the batch counterpart to ReadWithGap: it never blocks, and
+// returns (0, 0, 0) rather than a gap when the next item simply isn't
+// ready yet.
+//
+// Example:
+//
+//	rb := ringbuffer.New[int](64)
+//	rb.WriteBatch([]int{1, 2, 3})
+//
+//	var cursor uint64
+//	out := make([]int, 8)
+//	n, gapStart, gapEnd := rb.ReadBatch(&cursor, out)
+//	fmt.Println(out[:n]) // [1 2 3]
+func (rb *RingBuffer[T]) ReadBatch(cursor *uint64, out []T) (n int, gapStart, gapEnd uint64) {
+	if len(out) == 0 {
+		return 0, 0, 0
+	}
+
+	nextSeq := *cursor + 1
+	idx := nextSeq & rb.mask
+	seq := rb.buffer[idx].sequence.Load()
+
+	if seq < nextSeq {
+		return 0, 0, 0
+	}
+
+	if seq > nextSeq {
+		if rb.publishedCursor.Load() < nextSeq {
+			return 0, 0, 0
+		}
+		rb.statsGapsDetected.Add(1)
+		return 0, nextSeq, seq - 1
+	}
+
+	avail := rb.readyCursor().Load() - *cursor
+	if uint64(len(out)) < avail {
+		avail = uint64(len(out))
+	}
+
+	for i := uint64(0); i < avail; i++ {
+		out[i] = rb.buffer[(nextSeq+i)&rb.mask].data
+	}
+
+	*cursor += avail
+
+	return int(avail), 0, 0
+}
+
+// ReadBlocking reads the next item, waiting for it to become available
+// using strategy instead of returning immediately like Read does. This
+// lets a consumer trade latency for CPU usage without hand-rolling a
+// retry loop around Read.
+//
+// Example:
+//
+//	rb := ringbuffer.New[int](64, ringbuffer.WithWaitStrategy(ringbuffer.SleepingWait{}))
+//
+//	var cursor uint64
+//	data := rb.ReadBlocking(&cursor, ringbuffer.SleepingWait{})
+func (rb *RingBuffer[T]) ReadBlocking(cursor *uint64, strategy WaitStrategy) T {
+	nextSeq := *cursor + 1
+
+	for {
+		strategy.WaitFor(nextSeq, rb.readyCursor())
+
+		if data, ok := rb.Read(cursor); ok {
+			return data
+		}
+	}
+}
+
+// ReadBatchWait waits for at least one item to become available using
+// strategy, then drains up to max contiguous ready items in a single
+// call, returning the items read and their count. Unlike ReadBatch, it
+// blocks (per strategy) instead of returning immediately when nothing is
+// ready yet, and does not report gaps.
+//
+// Example:
+//
+//	rb := ringbuffer.New[int](64)
+//
+//	var cursor uint64
+//	items, n := rb.ReadBatchWait(&cursor, 16, ringbuffer.YieldingWait{})
+func (rb *RingBuffer[T]) ReadBatchWait(cursor *uint64, max int, strategy WaitStrategy) ([]T, int) {
+	nextSeq := *cursor + 1
+	strategy.WaitFor(nextSeq, rb.readyCursor())
+
+	items := make([]T, 0, max)
+	for len(items) < max {
+		data, ok := rb.Read(cursor)
+		if !ok {
+			break
+		}
+		items = append(items, data)
+	}
+
+	return items, len(items)
+}
+
 // Capacity returns the fixed capacity of the ring buffer.
 // The capacity is set at creation time and never changes.
 //
@@ -81,6 +81,121 @@ func TestRingBuffer_Overwrite(t *testing.T) {
 	}
 }
 
+func TestRingBuffer_MultipleProducers(t *testing.T) {
+	const producers = 8
+	const perProducer = 200
+	const size = 2048 // must exceed producers*perProducer so nothing is
+	// overwritten before the consumer reads it below, since all writes
+	// happen before any read.
+
+	rb := New[int](size, WithMultipleProducers())
+
+	var wg sync.WaitGroup
+	wg.Add(producers)
+	for p := 0; p < producers; p++ {
+		go func() {
+			defer wg.Done()
+			for i := 0; i < perProducer; i++ {
+				rb.Write(i)
+			}
+		}()
+	}
+	wg.Wait()
+
+	var cursor uint64
+	var read int
+	for {
+		if _, ok := rb.Read(&cursor); ok {
+			read++
+			continue
+		}
+		if cursor >= producers*perProducer {
+			break
+		}
+		runtime.Gosched()
+	}
+
+	if read != producers*perProducer {
+		t.Fatalf("expected %d reads, got %d", producers*perProducer, read)
+	}
+}
+
+func TestRingBuffer_ClaimPublish(t *testing.T) {
+	rb := New[int](64, WithMultipleProducers())
+
+	seq := rb.Claim()
+	slot := rb.Slot(seq)
+	slot.data = 7
+	rb.Publish(seq)
+
+	var cursor uint64
+	data, ok := rb.Read(&cursor)
+	if !ok {
+		t.Fatal("expected published data to be readable")
+	}
+	if data != 7 {
+		t.Fatalf("expected 7, got %d", data)
+	}
+}
+
+func TestRingBuffer_WriteBatchReadBatch(t *testing.T) {
+	rb := New[int](64)
+
+	rb.WriteBatch([]int{0, 1, 2, 3, 4})
+
+	var cursor uint64
+	out := make([]int, 8)
+	n, gapStart, gapEnd := rb.ReadBatch(&cursor, out)
+
+	if n != 5 {
+		t.Fatalf("expected 5 items, got %d", n)
+	}
+	if gapStart != 0 || gapEnd != 0 {
+		t.Fatalf("unexpected gap: %d-%d", gapStart, gapEnd)
+	}
+	for i := 0; i < n; i++ {
+		if out[i] != i {
+			t.Fatalf("expected %d, got %d", i, out[i])
+		}
+	}
+}
+
+func TestRingBuffer_ReadBatch_Gap(t *testing.T) {
+	size := uint64(16)
+	rb := New[int](size)
+
+	for i := uint64(0); i < size; i++ {
+		rb.Write(int(i))
+	}
+
+	cursor := size - 1
+
+	for i := size; i < size*2; i++ {
+		rb.Write(int(i))
+	}
+
+	out := make([]int, 8)
+	n, gapStart, gapEnd := rb.ReadBatch(&cursor, out)
+
+	if n != 0 {
+		t.Fatalf("expected 0 items on gap, got %d", n)
+	}
+	if gapStart != 16 || gapEnd != 31 {
+		t.Fatalf("unexpected gap: %d-%d", gapStart, gapEnd)
+	}
+}
+
+func TestRingBuffer_ReadBatch_Empty(t *testing.T) {
+	rb := New[int](64)
+	var cursor uint64
+
+	out := make([]int, 8)
+	n, _, _ := rb.ReadBatch(&cursor, out)
+	if n != 0 {
+		t.Fatalf("expected 0 items, got %d", n)
+	}
+}
+
 func TestRingBuffer_MultipleReaders(t *testing.T) {
 	rb := New[uint64](1024)
 	totalWrites := uint64(1000)
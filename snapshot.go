@@ -0,0 +1,84 @@
+// Copyright (c) 2025 Joshua Skootsky
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Alternatively, you can license this code under a commercial license.
+// Contact: joshua.skootsky@gmail.com
+
+package ringbuffer
+
+// Snapshot atomically captures the currently-published contiguous range
+// of the buffer without mutating any cursor, for the "grab the last N
+// events on panic/SIGQUIT" pattern that Go's own runtime profbuf package
+// supports. It is safe to call concurrently with an in-progress Write,
+// and does not disturb the live consumer's cursor.
+//
+// It walks backward from the newest claimed sequence, copying data while
+// each slot's sequence number still matches the sequence the walk
+// expects. The walk stops at the first slot whose sequence doesn't
+// match: that slot is either mid-overwrite by the producer, or (with
+// WithMultipleProducers) claimed but not yet published. items is
+// returned oldest-first; firstSeq is the sequence number of items[0], so
+// callers can tell how much history survived.
+//
+// Example:
+//
+//	rb := ringbuffer.New[Event](1024)
+//	// ... producer runs ...
+//
+//	items, firstSeq := rb.Snapshot()
+//	for i, item := range items {
+//	    fmt.Println(firstSeq+uint64(i), item)
+//	}
+func (rb *RingBuffer[T]) Snapshot() (items []T, firstSeq uint64) {
+	end := rb.writerCursor.Load()
+	if end == 0 {
+		return nil, 0
+	}
+
+	capacity := uint64(len(rb.buffer))
+	count := capacity
+	if end < count {
+		count = end
+	}
+
+	items = make([]T, 0, count)
+
+	for i := uint64(0); i < count; i++ {
+		seq := end - i
+		slot := &rb.buffer[seq&rb.mask]
+		if slot.sequence.Load() != seq {
+			break
+		}
+		items = append(items, slot.data)
+		firstSeq = seq
+	}
+
+	for l, r := 0, len(items)-1; l < r; l, r = l+1, r-1 {
+		items[l], items[r] = items[r], items[l]
+	}
+
+	return items, firstSeq
+}
+
+// ForEach calls fn once for each item in a Snapshot, oldest first, passing
+// its sequence number and value, stopping early if fn returns false. It
+// never mutates any cursor, so it is safe to use for profiling or
+// debugging alongside a live producer and consumer.
+//
+// Example:
+//
+//	rb.ForEach(func(seq uint64, v Event) bool {
+//	    fmt.Println(seq, v)
+//	    return true
+//	})
+func (rb *RingBuffer[T]) ForEach(fn func(seq uint64, v T) bool) {
+	items, firstSeq := rb.Snapshot()
+	for i, v := range items {
+		if !fn(firstSeq+uint64(i), v) {
+			return
+		}
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright (c) 2025 Joshua Skootsky
+//
+// Licensed under the Business Source License 1.1
+// You may use this file only in compliance with one of:
+// 1. BSL-1.1 (non-production use is free)
+// 2. Commercial License (contact for pricing)
+//
+// After 4 years (2029-01-01), this becomes Apache-2.0
+
+package ringbuffer
+
+import "testing"
+
+func TestRingBuffer_Snapshot_Empty(t *testing.T) {
+	rb := New[int](64)
+
+	items, firstSeq := rb.Snapshot()
+	if len(items) != 0 || firstSeq != 0 {
+		t.Fatalf("expected empty snapshot, got %v firstSeq=%d", items, firstSeq)
+	}
+}
+
+func TestRingBuffer_Snapshot_PartialFill(t *testing.T) {
+	rb := New[int](64)
+
+	for i := 0; i < 5; i++ {
+		rb.Write(i)
+	}
+
+	items, firstSeq := rb.Snapshot()
+	if firstSeq != 1 {
+		t.Fatalf("expected firstSeq 1, got %d", firstSeq)
+	}
+	for i, v := range items {
+		if v != i {
+			t.Fatalf("expected %d, got %d", i, v)
+		}
+	}
+}
+
+func TestRingBuffer_Snapshot_WrappedFull(t *testing.T) {
+	const size = 16
+	rb := New[int](size)
+
+	for i := uint64(0); i < size*3; i++ {
+		rb.Write(int(i))
+	}
+
+	items, firstSeq := rb.Snapshot()
+	if len(items) != size {
+		t.Fatalf("expected %d items, got %d", size, len(items))
+	}
+
+	expectedFirst := int(size*3 - size)
+	if int(firstSeq)-1 != expectedFirst {
+		t.Fatalf("expected firstSeq-1 %d, got %d", expectedFirst, firstSeq-1)
+	}
+
+	for i, v := range items {
+		if v != expectedFirst+i {
+			t.Fatalf("expected %d, got %d", expectedFirst+i, v)
+		}
+	}
+}
+
+func TestRingBuffer_ForEach(t *testing.T) {
+	rb := New[int](64)
+	for i := 0; i < 5; i++ {
+		rb.Write(i)
+	}
+
+	var got []int
+	rb.ForEach(func(seq uint64, v int) bool {
+		got = append(got, v)
+		return true
+	})
+
+	if len(got) != 5 {
+		t.Fatalf("expected 5 items, got %d", len(got))
+	}
+	for i, v := range got {
+		if v != i {
+			t.Fatalf("expected %d, got %d", i, v)
+		}
+	}
+}
+
+func TestRingBuffer_ForEach_EarlyStop(t *testing.T) {
+	rb := New[int](64)
+	for i := 0; i < 5; i++ {
+		rb.Write(i)
+	}
+
+	var got []int
+	rb.ForEach(func(seq uint64, v int) bool {
+		got = append(got, v)
+		return len(got) < 2
+	})
+
+	if len(got) != 2 {
+		t.Fatalf("expected early stop after 2 items, got %d", len(got))
+	}
+}
@@ -0,0 +1,179 @@
+// Copyright (c) 2025 Joshua Skootsky
+//
+// This Source Code Form is subject to the terms of the Mozilla Public
+// License, v. 2.0. If a copy of the MPL was not distributed with this
+// file, You can obtain one at https://mozilla.org/MPL/2.0/.
+//
+// Alternatively, you can license this code under a commercial license.
+// Contact: joshua.skootsky@gmail.com
+
+package ringbuffer
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// WaitStrategy determines how a consumer waits for a sequence number to
+// become available. WaitFor blocks (by whatever means the strategy
+// chooses) until cursor.Load() >= seq, then returns the observed cursor
+// value.
+//
+// Strategies trade latency against CPU usage: BusySpinWait gives the
+// lowest latency at full CPU cost, while BlockingWait gives the lowest
+// CPU cost at the price of OS-thread wakeup latency.
+type WaitStrategy interface {
+	WaitFor(seq uint64, cursor *atomic.Uint64) uint64
+}
+
+// BusySpinWait waits by spinning on an atomic load with no back-off. It
+// gives the lowest possible latency and is appropriate when a dedicated
+// CPU core is available for the consumer, but burns that core at 100%
+// while waiting.
+type BusySpinWait struct{}
+
+// WaitFor implements WaitStrategy.
+func (BusySpinWait) WaitFor(seq uint64, cursor *atomic.Uint64) uint64 {
+	for {
+		if avail := cursor.Load(); avail >= seq {
+			return avail
+		}
+	}
+}
+
+// YieldingWait spins SpinTries times, then yields the processor with
+// runtime.Gosched between further attempts. It is a middle ground between
+// BusySpinWait's latency and friendlier CPU usage when no dedicated core
+// is available.
+type YieldingWait struct {
+	// SpinTries is the number of busy-spin attempts before yielding.
+	// If zero, a default of 100 is used.
+	SpinTries int
+}
+
+// WaitFor implements WaitStrategy.
+func (y YieldingWait) WaitFor(seq uint64, cursor *atomic.Uint64) uint64 {
+	spinTries := y.SpinTries
+	if spinTries <= 0 {
+		spinTries = 100
+	}
+
+	for i := 0; ; i++ {
+		if avail := cursor.Load(); avail >= seq {
+			return avail
+		}
+		if i >= spinTries {
+			runtime.Gosched()
+		}
+	}
+}
+
+// SleepingWait spins SpinTries times, then yields YieldTries times via
+// runtime.Gosched, then sleeps with exponential backoff from MinDelay up
+// to MaxDelay. It trades latency for much lower CPU usage than
+// BusySpinWait or YieldingWait under sustained waiting.
+type SleepingWait struct {
+	// SpinTries is the number of busy-spin attempts before yielding.
+	// If zero, a default of 100 is used.
+	SpinTries int
+	// YieldTries is the number of runtime.Gosched attempts before
+	// sleeping. If zero, a default of 100 is used.
+	YieldTries int
+	// MinDelay is the initial sleep duration. If zero, a default of
+	// 50 microseconds is used.
+	MinDelay time.Duration
+	// MaxDelay caps the exponential backoff. If zero, a default of
+	// 1 millisecond is used.
+	MaxDelay time.Duration
+}
+
+// WaitFor implements WaitStrategy.
+func (s SleepingWait) WaitFor(seq uint64, cursor *atomic.Uint64) uint64 {
+	spinTries := s.SpinTries
+	if spinTries <= 0 {
+		spinTries = 100
+	}
+	yieldTries := s.YieldTries
+	if yieldTries <= 0 {
+		yieldTries = 100
+	}
+	minDelay := s.MinDelay
+	if minDelay <= 0 {
+		minDelay = 50 * time.Microsecond
+	}
+	maxDelay := s.MaxDelay
+	if maxDelay <= 0 {
+		maxDelay = time.Millisecond
+	}
+
+	delay := minDelay
+	for i := 0; ; i++ {
+		if avail := cursor.Load(); avail >= seq {
+			return avail
+		}
+
+		switch {
+		case i < spinTries:
+			// busy-spin
+		case i < spinTries+yieldTries:
+			runtime.Gosched()
+		default:
+			time.Sleep(delay)
+			if delay < maxDelay {
+				delay *= 2
+				if delay > maxDelay {
+					delay = maxDelay
+				}
+			}
+		}
+	}
+}
+
+// BlockingWait waits on a sync.Cond instead of spinning, giving the
+// lowest possible CPU usage while waiting. It must be signaled by the
+// producer after publishing; RingBuffer does this automatically when the
+// buffer was created with WithWaitStrategy(blockingWait).
+//
+// The zero value is not usable; create one with NewBlockingWait.
+type BlockingWait struct {
+	mu   sync.Mutex
+	cond *sync.Cond
+}
+
+// NewBlockingWait creates a ready-to-use BlockingWait.
+//
+// Example:
+//
+//	bw := ringbuffer.NewBlockingWait()
+//	rb := ringbuffer.New[int](64, ringbuffer.WithWaitStrategy(bw))
+//
+//	var cursor uint64
+//	data := rb.ReadBlocking(&cursor, bw)
+func NewBlockingWait() *BlockingWait {
+	bw := &BlockingWait{}
+	bw.cond = sync.NewCond(&bw.mu)
+	return bw
+}
+
+// WaitFor implements WaitStrategy.
+func (b *BlockingWait) WaitFor(seq uint64, cursor *atomic.Uint64) uint64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for {
+		if avail := cursor.Load(); avail >= seq {
+			return avail
+		}
+		b.cond.Wait()
+	}
+}
+
+// notify wakes all goroutines blocked in WaitFor. RingBuffer calls this
+// from Publish after storing a slot's sequence number.
+func (b *BlockingWait) notify() {
+	b.mu.Lock()
+	b.cond.Broadcast()
+	b.mu.Unlock()
+}
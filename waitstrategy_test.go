@@ -0,0 +1,80 @@
+// Copyright (c) 2025 Joshua Skootsky
+//
+// Licensed under the Business Source License 1.1
+// You may use this file only in compliance with one of:
+// 1. BSL-1.1 (non-production use is free)
+// 2. Commercial License (contact for pricing)
+//
+// After 4 years (2029-01-01), this becomes Apache-2.0
+
+package ringbuffer
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRingBuffer_ReadBlocking(t *testing.T) {
+	rb := New[int](64)
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		time.Sleep(10 * time.Millisecond)
+		rb.Write(42)
+	}()
+
+	var cursor uint64
+	data := rb.ReadBlocking(&cursor, YieldingWait{})
+	if data != 42 {
+		t.Fatalf("expected 42, got %d", data)
+	}
+
+	wg.Wait()
+}
+
+func TestRingBuffer_ReadBatchWait(t *testing.T) {
+	rb := New[int](64)
+
+	for i := 0; i < 10; i++ {
+		rb.Write(i)
+	}
+
+	var cursor uint64
+	items, n := rb.ReadBatchWait(&cursor, 5, BusySpinWait{})
+	if n != 5 {
+		t.Fatalf("expected 5 items, got %d", n)
+	}
+	for i, v := range items {
+		if v != i {
+			t.Fatalf("expected %d, got %d", i, v)
+		}
+	}
+}
+
+func TestBlockingWait_WakesOnPublish(t *testing.T) {
+	bw := NewBlockingWait()
+	rb := New[int](64, WithWaitStrategy(bw))
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		time.Sleep(10 * time.Millisecond)
+		rb.Write(7)
+	}()
+
+	var cursor uint64
+	start := time.Now()
+	data := rb.ReadBlocking(&cursor, bw)
+	if data != 7 {
+		t.Fatalf("expected 7, got %d", data)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Fatalf("ReadBlocking took too long: %s", elapsed)
+	}
+
+	wg.Wait()
+}